@@ -0,0 +1,183 @@
+/*
+Copyright © 2021 CompuZest <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/compuzest/zlifecycle-cli/common"
+	"github.com/compuzest/zlifecycle-cli/render"
+	stablev1 "github.com/compuzest/zlifecycle-il-operator/api/v1"
+	"github.com/compuzest/zlifecycle-il-operator/controller/env"
+	"github.com/ghodss/yaml"
+	"github.com/sirupsen/logrus"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	renderOutputDir     string
+	renderRepos         map[string]string
+	renderCompany       string
+	renderConfigRepo    string
+	renderTemplatesPath string
+	renderTeamFile      string
+	renderCompanyFile   string
+	renderOutputs       map[string]string
+	renderVerbose       bool
+)
+
+// renderCmd represents the render command
+var renderCmd = &cobra.Command{
+	Use:     "render file",
+	Example: "render path/to/environment.yaml --repo git@github.com:org/modules.git=../modules -o il",
+	Aliases: []string{"r"},
+	Args:    cobra.ExactArgs(1),
+	Short:   "Render the IL of a zLifecycle Environment locally",
+	Long: `Renders the terraform IL, the Argo CD applications and the workflows which the zLifecycle operator would generate for an Environment,
+without access to the cluster, the secret backend or GitHub. Repositories are read from the local checkouts passed
+with --repo, or cloned with the git binary otherwise. Secrets are not looked up, so secret versions are left empty.
+
+The IaC engine, maintenance windows and change freezes which the Environment inherits are read from the Team and
+Company passed with --team-file and --company-file. Component outputs are not read from zLstate, so the outputs
+referenced by valueFrom in argocd, helm and kustomize components are passed with --value-from, and the applications
+of a component are skipped if one of its outputs is missing.
+
+The IL is written to the terraform-il and zlifecycle-il subdirectories of the output directory. Environment variables
+read by the operator, like IL_TERRAFORM_REPOSITORY_URL, are used the same way to render the workflows.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		path := args[0]
+		dat, err := readFile(path)
+		common.HandleError(err, 2)
+		e, err := decodeEnvironment(dat)
+		common.HandleError(err, 2)
+		err = renderEnvironment(e)
+		if err != nil {
+			fmt.Println(err)
+		}
+		common.HandleError(err, 2)
+		fmt.Printf("Environment IL rendered to %s\n", renderOutputDir)
+		os.Exit(0)
+	},
+}
+
+func decodeEnvironment(dat []byte) (*stablev1.Environment, error) {
+	e := stablev1.Environment{}
+	if err := yaml.Unmarshal(dat, &e); err != nil {
+		fmt.Println("Error while unmarshalling Environment YAML file")
+		return nil, err
+	}
+	if e.Kind != "Environment" {
+		fmt.Printf("Unsupported k8s resource kind: %s\n", e.Kind)
+		return nil, fmt.Errorf("unsupported k8s resource")
+	}
+	return &e, nil
+}
+
+func decodeTeam(dat []byte) (*stablev1.Team, error) {
+	t := stablev1.Team{}
+	if err := yaml.Unmarshal(dat, &t); err != nil {
+		fmt.Println("Error while unmarshalling Team YAML file")
+		return nil, err
+	}
+	if t.Kind != "Team" {
+		fmt.Printf("Unsupported k8s resource kind: %s\n", t.Kind)
+		return nil, fmt.Errorf("unsupported k8s resource")
+	}
+	return &t, nil
+}
+
+func decodeCompany(dat []byte) (*stablev1.Company, error) {
+	c := stablev1.Company{}
+	if err := yaml.Unmarshal(dat, &c); err != nil {
+		fmt.Println("Error while unmarshalling Company YAML file")
+		return nil, err
+	}
+	if c.Kind != "Company" {
+		fmt.Printf("Unsupported k8s resource kind: %s\n", c.Kind)
+		return nil, fmt.Errorf("unsupported k8s resource")
+	}
+	return &c, nil
+}
+
+func renderEnvironment(e *stablev1.Environment) error {
+	if renderCompany != "" {
+		env.Config.CompanyName = renderCompany
+	}
+
+	logger := logrus.New()
+	logger.SetOutput(os.Stderr)
+	logger.SetLevel(logrus.WarnLevel)
+	if renderVerbose {
+		logger.SetLevel(logrus.InfoLevel)
+	}
+
+	var team *stablev1.Team
+	if renderTeamFile != "" {
+		dat, err := readFile(renderTeamFile)
+		if err != nil {
+			return err
+		}
+		if team, err = decodeTeam(dat); err != nil {
+			return err
+		}
+	}
+	var company *stablev1.Company
+	if renderCompanyFile != "" {
+		dat, err := readFile(renderCompanyFile)
+		if err != nil {
+			return err
+		}
+		if company, err = decodeCompany(dat); err != nil {
+			return err
+		}
+	}
+
+	repos := common.NewRepoResolver(common.NormalizeRepoURLs(renderRepos))
+	defer repos.Cleanup()
+
+	opts := render.Options{
+		OutputDir:     renderOutputDir,
+		ConfigRepoURL: renderConfigRepo,
+		TemplatesPath: renderTemplatesPath,
+		Team:          team,
+		Company:       company,
+		Outputs:       renderOutputs,
+		GitClient:     common.NewLocalGit(repos),
+		Subscriber:    common.NoopSubscriber{},
+		Log:           logrus.NewEntry(logger),
+	}
+	if err := render.Environment(&opts, e); err != nil {
+		fmt.Println("Error rendering Environment IL")
+		return err
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(renderCmd)
+
+	renderCmd.Flags().StringVarP(&renderOutputDir, "output", "o", "il", "Directory to render the IL to")
+	renderCmd.Flags().StringToStringVar(&renderRepos, "repo", nil, "Use a local checkout instead of cloning a repository, e.g. --repo git@github.com:org/modules.git=../modules")
+	renderCmd.Flags().StringVar(&renderCompany, "company", "", "Company name used in the rendered IL, defaults to the COMPANY_NAME environment variable")
+	renderCmd.Flags().StringVar(&renderConfigRepo, "config-repo", "", "Company config repo which holds the terraform template overrides")
+	renderCmd.Flags().StringVar(&renderTemplatesPath, "templates-path", "", "Path of the terraform template overrides in the company config repo")
+	renderCmd.Flags().StringVar(&renderTeamFile, "team-file", "", "Team the Environment belongs to, used to resolve the inherited IaC engine and change windows")
+	renderCmd.Flags().StringVar(&renderCompanyFile, "company-file", "", "Company the Environment belongs to, used to resolve the inherited IaC engine and change windows")
+	renderCmd.Flags().StringToStringVar(&renderOutputs, "value-from", nil, "Value of a component output referenced by valueFrom, e.g. --value-from networking.vpc_id=vpc-123")
+	renderCmd.Flags().BoolVarP(&renderVerbose, "verbose", "v", false, "Log the codegen steps")
+}
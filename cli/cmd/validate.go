@@ -28,6 +28,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	moduleInputs bool
+	localRepos   map[string]string
+)
+
 // validateCmd represents the validate command
 var validateCmd = &cobra.Command{
 	Use:   "validate file",
@@ -80,6 +85,14 @@ func validate(kind string, dat []byte) error {
 			fmt.Println("Error validating Environment resource")
 			return err
 		}
+		if moduleInputs {
+			repos := common.NewRepoResolver(localRepos)
+			defer repos.Cleanup()
+			if err := validators.ValidateModuleInputs(env, repos); err != nil {
+				fmt.Println("Error validating Environment module inputs")
+				return err
+			}
+		}
 	default:
 		fmt.Printf("Unsupported k8s resource kind: %s", kind)
 		return errors.New("unsupported k8s resource")
@@ -90,6 +103,9 @@ func validate(kind string, dat []byte) error {
 func init() {
 	rootCmd.AddCommand(validateCmd)
 
+	validateCmd.Flags().BoolVar(&moduleInputs, "module-inputs", false, "Validate terraform component inputs against the variables declared by git sourced modules")
+	validateCmd.Flags().StringToStringVar(&localRepos, "repo", nil, "Use a local checkout instead of cloning a repository, e.g. --repo git@github.com:org/modules.git=../modules")
+
 	// Here you will define your flags and configuration settings.
 
 	// Cobra supports Persistent Flags which will work for this command
@@ -0,0 +1,114 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/compuzest/zlifecycle-il-operator/controller/codegen/file"
+	"github.com/compuzest/zlifecycle-il-operator/controller/common/git"
+	"github.com/compuzest/zlifecycle-il-operator/controller/util"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	kClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	errReadOnly = errors.New("local git client is read-only")
+	errNoRemote = errors.New("local git client has no remote repositories")
+)
+
+// LocalGit implements the operator git API on top of a RepoResolver, so that the operator codegen
+// reads repositories from local checkouts instead of cloning them from GitHub. It never commits or pushes.
+type LocalGit struct {
+	repos *RepoResolver
+	files file.API
+	// repo and dir are the repository and the directory of the last clone, which Checkout replaces
+	repo string
+	dir  string
+}
+
+// NewLocalGit returns a LocalGit which resolves repositories through repos. The URLs of the local checkouts
+// of repos have to be rewritten the same way the operator rewrites them before cloning, see NormalizeRepoURLs.
+func NewLocalGit(repos *RepoResolver) *LocalGit {
+	return &LocalGit{repos: repos, files: file.NewOSFileService()}
+}
+
+// NormalizeRepoURLs rewrites the repository URLs of local checkouts to the HTTPS form used by the operator codegen.
+func NormalizeRepoURLs(local map[string]string) map[string]string {
+	normalized := make(map[string]string, len(local))
+	for url, dir := range local {
+		normalized[util.RewriteGitHubURLToHTTPS(url, false)] = dir
+	}
+	return normalized
+}
+
+// Clone copies the resolved repository into dir.
+func (g *LocalGit) Clone(repo string, dir string) error {
+	src, err := g.repos.Dir(repo)
+	if err != nil {
+		return err
+	}
+	if err := g.files.CopyDirContent(src, dir, true); err != nil {
+		return fmt.Errorf("error copying repository %s from %s: %w", repo, src, err)
+	}
+	g.repo, g.dir = repo, dir
+	return nil
+}
+
+// Checkout replaces the content of the last cloned directory with the repository at ref.
+func (g *LocalGit) Checkout(ref string) error {
+	if g.dir == "" {
+		return git.ErrRepoNotCloned
+	}
+	src, err := g.repos.DirAt(g.repo, ref)
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(g.dir); err != nil {
+		return fmt.Errorf("error removing the content of %s: %w", g.dir, err)
+	}
+	if err := g.files.CopyDirContent(src, g.dir, true); err != nil {
+		return fmt.Errorf("error copying repository %s at %s from %s: %w", g.repo, ref, src, err)
+	}
+	return nil
+}
+
+func (g *LocalGit) Open(path string) error {
+	return nil
+}
+
+func (g *LocalGit) Commit(nfo *git.CommitInfo) (*object.Commit, error) {
+	return nil, errReadOnly
+}
+
+func (g *LocalGit) HeadCommitHash() (hash string, err error) {
+	return "", errReadOnly
+}
+
+func (g *LocalGit) RemoteHeadCommitHash(repo string) (hash string, err error) {
+	return "", errNoRemote
+}
+
+func (g *LocalGit) CommitAndPush(nfo *git.CommitInfo) (pushed bool, err error) {
+	return false, errReadOnly
+}
+
+func (g *LocalGit) CommitAndPushBranch(nfo *git.CommitInfo, branch string) (changed bool, err error) {
+	return false, errReadOnly
+}
+
+func (g *LocalGit) Push() error {
+	return errReadOnly
+}
+
+// NoopSubscriber is a git Subscriber which does not subscribe to any repository, as there is nothing to reconcile locally.
+type NoopSubscriber struct{}
+
+func (NoopSubscriber) Subscribe(repositoryURL string, subscriber kClient.ObjectKey) bool {
+	return false
+}
+
+var (
+	_ git.API        = &LocalGit{}
+	_ git.Subscriber = NoopSubscriber{}
+)
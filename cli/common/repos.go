@@ -0,0 +1,82 @@
+package common
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+)
+
+// RepoResolver resolves repository URLs to local directories, either from local checkouts
+// passed on the command line or by cloning the repository with the git binary.
+type RepoResolver struct {
+	local  map[string]string
+	clones map[string]string
+}
+
+func NewRepoResolver(local map[string]string) *RepoResolver {
+	return &RepoResolver{local: local, clones: map[string]string{}}
+}
+
+// Dir returns the local directory which contains the default branch of the repository.
+func (r *RepoResolver) Dir(repoURL string) (string, error) {
+	return r.DirAt(repoURL, "")
+}
+
+// DirAt returns the local directory which contains the repository at ref, which can be a tag, a branch or a commit.
+// An empty ref resolves to the default branch. Local checkouts are used as they are, whatever ref is requested.
+func (r *RepoResolver) DirAt(repoURL string, ref string) (string, error) {
+	if dir, ok := r.local[repoURL]; ok {
+		return dir, nil
+	}
+	key := repoURL
+	if ref != "" {
+		key = repoURL + "@" + ref
+	}
+	if dir, ok := r.clones[key]; ok {
+		return dir, nil
+	}
+
+	dir, err := ioutil.TempDir("", "zl-repo-")
+	if err != nil {
+		return "", err
+	}
+	if err := clone(repoURL, ref, dir); err != nil {
+		_ = os.RemoveAll(dir)
+		return "", err
+	}
+	r.clones[key] = dir
+
+	return dir, nil
+}
+
+func clone(repoURL string, ref string, dir string) error {
+	// a shallow clone only contains the default branch, so refs which can be commits need a full clone
+	args := []string{"clone", "--quiet", "--depth", "1", repoURL, dir}
+	if ref != "" {
+		args = []string{"clone", "--quiet", repoURL, dir}
+	}
+	if err := runGit(args...); err != nil {
+		return fmt.Errorf("error cloning repository %s: %w", repoURL, err)
+	}
+	if ref == "" {
+		return nil
+	}
+	if err := runGit("-C", dir, "checkout", "--quiet", ref); err != nil {
+		return fmt.Errorf("error checking out %s of repository %s: %w", ref, repoURL, err)
+	}
+	return nil
+}
+
+func runGit(args ...string) error {
+	cmd := exec.Command("git", args...)
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// Cleanup removes all cloned repositories.
+func (r *RepoResolver) Cleanup() {
+	for _, dir := range r.clones {
+		_ = os.RemoveAll(dir)
+	}
+}
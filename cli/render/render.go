@@ -0,0 +1,139 @@
+package render
+
+import (
+	"fmt"
+	"path/filepath"
+
+	stablev1 "github.com/compuzest/zlifecycle-il-operator/api/v1"
+	"github.com/compuzest/zlifecycle-il-operator/controller/codegen/apps"
+	"github.com/compuzest/zlifecycle-il-operator/controller/codegen/component"
+	"github.com/compuzest/zlifecycle-il-operator/controller/codegen/file"
+	"github.com/compuzest/zlifecycle-il-operator/controller/codegen/il"
+	"github.com/compuzest/zlifecycle-il-operator/controller/codegen/interpolator"
+	"github.com/compuzest/zlifecycle-il-operator/controller/codegen/terraform"
+	"github.com/compuzest/zlifecycle-il-operator/controller/codegen/window"
+	"github.com/compuzest/zlifecycle-il-operator/controller/codegen/workflow"
+	"github.com/compuzest/zlifecycle-il-operator/controller/common/git"
+	"github.com/sirupsen/logrus"
+	kClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	TerraformILDirectory  = "terraform-il"
+	ZLifecycleILDirectory = "zlifecycle-il"
+)
+
+// Options configures where the IL is rendered to and where repositories are read from.
+type Options struct {
+	OutputDir string
+	// ConfigRepoURL is the company config repo which holds the terraform template overrides
+	ConfigRepoURL string
+	// TemplatesPath is the path of the terraform template overrides in the company config repo
+	TemplatesPath string
+	// Team and Company are optional, and provide the IaC engine, maintenance windows and change freezes the environment inherits
+	Team    *stablev1.Team
+	Company *stablev1.Company
+	// Outputs are the values of the component outputs referenced by valueFrom, keyed by valueFrom, as the operator reads them from zLstate
+	Outputs    map[string]string
+	GitClient  git.API
+	Subscriber git.Subscriber
+	Log        *logrus.Entry
+}
+
+// Environment renders the terraform IL of terraform components, the applications of argocd, helm and kustomize components
+// and the workflows of an environment into the terraform-il and zlifecycle-il subdirectories of the output directory,
+// using the same codegen as the operator. Job components only run as tasks of the workflow of workflows.
+// Secrets and customer terraform state configs are not looked up, so secret versions are left empty and
+// the default terraform state backend is used.
+func Environment(opts *Options, e *stablev1.Environment) error {
+	interpolated, err := interpolator.Interpolate(*e, opts.Team, opts.Company)
+	if err != nil {
+		return fmt.Errorf("error interpolating environment: %w", err)
+	}
+	changeWindows, err := window.WorkflowParam(e, window.Resolve(e, opts.Team, opts.Company))
+	if err != nil {
+		return fmt.Errorf("error encoding change windows: %w", err)
+	}
+
+	tpl, err := terraform.LoadCompanyTemplates(opts.GitClient, opts.ConfigRepoURL, opts.TemplatesPath, opts.Log)
+	if err != nil {
+		return fmt.Errorf("error loading terraform templates: %w", err)
+	}
+
+	fileService := file.NewOSFileService()
+	tfOpts := component.TerraformOptions{
+		FileService:   fileService,
+		GitClient:     opts.GitClient,
+		Subscriber:    opts.Subscriber,
+		Templates:     tpl,
+		SecretBackend: terraform.NewSecretBackendConfig(nil),
+		Log:           opts.Log,
+	}
+	appsOpts := component.AppsOptions{
+		FileService: fileService,
+		GitClient:   opts.GitClient,
+		Subscriber:  opts.Subscriber,
+		Log:         opts.Log,
+	}
+	key := kClient.ObjectKey{Name: interpolated.Name, Namespace: interpolated.Namespace}
+	tfILDir := filepath.Join(opts.OutputDir, TerraformILDirectory)
+	zlILDir := filepath.Join(opts.OutputDir, ZLifecycleILDirectory)
+	for _, ec := range interpolated.Spec.Components {
+		switch {
+		case ec.Type == stablev1.CompTypeTerraform:
+			tfDirectory := il.EnvironmentComponentTerraformDirectoryAbsolutePath(tfILDir, interpolated.Spec.TeamName, interpolated.Spec.EnvName, ec.Name)
+			if err := component.GenerateTerraform(&tfOpts, interpolated, ec, &key, tfDirectory); err != nil {
+				return fmt.Errorf("error generating terraform for environment component %s: %w", ec.Name, err)
+			}
+		case component.IsAppsComponent(ec):
+			cluster, outputs := resolveAppsOutputs(opts, ec)
+			if err := component.GenerateApps(&appsOpts, interpolated, ec, &key, cluster, outputs, zlILDir); err != nil {
+				return fmt.Errorf("error generating applications for environment component %s: %w", ec.Name, err)
+			}
+		}
+	}
+
+	componentsDir := il.EnvironmentComponentsDirectoryAbsolutePath(zlILDir, interpolated.Spec.TeamName, interpolated.Spec.EnvName)
+	wofw := workflow.GenerateWorkflowOfWorkflows(interpolated, nil, changeWindows)
+	if err := fileService.SaveYamlFile(*wofw, componentsDir, "wofw.yaml"); err != nil {
+		return fmt.Errorf("error saving workflow of workflows: %w", err)
+	}
+	for _, cwf := range workflow.GenerateDriftDetectionCronWorkflows(interpolated, nil) {
+		if err := fileService.SaveYamlFile(*cwf.CronWorkflow, componentsDir, cwf.FileName); err != nil {
+			return fmt.Errorf("error saving drift detection workflow %s: %w", cwf.FileName, err)
+		}
+	}
+
+	return nil
+}
+
+// resolveAppsOutputs returns the cluster and the variable outputs of an argocd, helm or kustomize component from the outputs
+// passed in the options. Like the operator, outputs which are not passed are rendered as stand-ins, which the workflow of
+// workflows resolves when it syncs the component. Clusters are not looked up in Argo CD or EKS, so the cluster name is used as is.
+func resolveAppsOutputs(opts *Options, ec *stablev1.EnvironmentComponent) (cluster string, outputs map[string]string) {
+	if ec.Cluster != nil {
+		cluster = ec.Cluster.Name
+		if ec.Cluster.ValueFrom != "" {
+			cluster = resolveOutput(opts, ec, ec.Cluster.ValueFrom)
+		}
+	}
+
+	outputs = map[string]string{}
+	for _, v := range ec.Variables {
+		if v.ValueFrom != "" {
+			outputs[v.ValueFrom] = resolveOutput(opts, ec, v.ValueFrom)
+		}
+	}
+
+	return cluster, outputs
+}
+
+func resolveOutput(opts *Options, ec *stablev1.EnvironmentComponent, valueFrom string) string {
+	value, ok := opts.Outputs[valueFrom]
+	if !ok {
+		opts.Log.Warnf("Output %s of environment component %s is not passed, it is resolved when the component is synced", valueFrom, ec.Name)
+		return apps.PendingOutput(valueFrom)
+	}
+
+	return value
+}
@@ -0,0 +1,158 @@
+package render_test
+
+import (
+	"flag"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/compuzest/zlifecycle-cli/common"
+	"github.com/compuzest/zlifecycle-cli/render"
+	stablev1 "github.com/compuzest/zlifecycle-il-operator/api/v1"
+	"github.com/compuzest/zlifecycle-il-operator/controller/env"
+	"github.com/ghodss/yaml"
+	"github.com/sirupsen/logrus"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata/golden")
+
+// the golden files are rendered with a fixed configuration, as namespaces are read from COMPANY_NAME
+func init() {
+	if err := os.Setenv("COMPANY_NAME", "zmart"); err != nil {
+		panic(err)
+	}
+	env.Config.CompanyName = "zmart"
+	env.Config.ILZLifecycleRepositoryURL = "git@github.com:zmart/zlifecycle-il.git"
+	env.Config.ILTerraformRepositoryURL = "git@github.com:zmart/terraform-il.git"
+	env.Config.GitHelmChartsRepository = "git@github.com:zmart/helm-charts.git"
+	env.Config.GitHelmChartTerraformConfigPath = "charts/terraform-config"
+}
+
+func decode(t *testing.T, path string, obj interface{}) {
+	t.Helper()
+
+	dat, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := yaml.Unmarshal(dat, obj); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func renderTestdata(t *testing.T, outputs map[string]string) string {
+	t.Helper()
+
+	e := &stablev1.Environment{}
+	decode(t, filepath.Join("testdata", "environment.yaml"), e)
+	team := &stablev1.Team{}
+	decode(t, filepath.Join("testdata", "team.yaml"), team)
+	company := &stablev1.Company{}
+	decode(t, filepath.Join("testdata", "company.yaml"), company)
+
+	repos := common.NewRepoResolver(common.NormalizeRepoURLs(map[string]string{
+		"git@github.com:zmart/apps.git": filepath.Join("testdata", "repos", "apps"),
+	}))
+	logger := logrus.New()
+	logger.SetOutput(os.Stderr)
+	logger.SetLevel(logrus.WarnLevel)
+	opts := render.Options{
+		OutputDir:  t.TempDir(),
+		Team:       team,
+		Company:    company,
+		Outputs:    outputs,
+		GitClient:  common.NewLocalGit(repos),
+		Subscriber: common.NoopSubscriber{},
+		Log:        logrus.NewEntry(logger),
+	}
+	if err := render.Environment(&opts, e); err != nil {
+		t.Fatalf("error rendering environment: %v", err)
+	}
+
+	return opts.OutputDir
+}
+
+func readTree(t *testing.T, dir string) map[string]string {
+	t.Helper()
+
+	files := map[string]string{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		dat, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = string(dat)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return files
+}
+
+func TestEnvironment(t *testing.T) {
+	out := renderTestdata(t, map[string]string{
+		"networking.vpc_id": "vpc-0a1b2c3d",
+		"eks.cluster_name":  "checkout-dev-eks",
+	})
+
+	golden := filepath.Join("testdata", "golden")
+	if *update {
+		if err := os.RemoveAll(golden); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Rename(out, golden); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	rendered := readTree(t, out)
+	expected := readTree(t, golden)
+	for name, content := range expected {
+		actual, ok := rendered[name]
+		if !ok {
+			t.Errorf("%s was not rendered", name)
+			continue
+		}
+		if actual != content {
+			t.Errorf("%s does not match the golden file, rerun the test with -update if the change is expected:\n%s", name, actual)
+		}
+	}
+	for name := range rendered {
+		if _, ok := expected[name]; !ok {
+			t.Errorf("%s has no golden file, rerun the test with -update if the change is expected", name)
+		}
+	}
+}
+
+func TestEnvironment_MissingOutputs(t *testing.T) {
+	out := renderTestdata(t, map[string]string{"networking.vpc_id": "vpc-0a1b2c3d"})
+	rendered := readTree(t, out)
+
+	// the platform and nginx components take their cluster from the eks outputs, which are resolved when the components are synced
+	componentsDir := "zlifecycle-il/team/checkout-team-environment/dev-environment-component/"
+	for _, name := range []string{"argocd/platform/Chart.yaml", "platform-app.yaml", "argocd/nginx/Chart.yaml", "nginx-app.yaml", "argocd/checkout/Chart.yaml", "checkout-app.yaml", "wofw.yaml"} {
+		if _, ok := rendered[componentsDir+name]; !ok {
+			t.Errorf("%s was not rendered", name)
+		}
+	}
+	for name, content := range rendered {
+		if !strings.HasPrefix(name, componentsDir+"argocd/nginx/templates/") {
+			continue
+		}
+		if !strings.Contains(content, `name: {{ required "output eks.cluster_name is not available" .Values.output_eks_cluster_name }}`) {
+			t.Errorf("%s does not take its cluster from the component app parameters:\n%s", name, content)
+		}
+	}
+}
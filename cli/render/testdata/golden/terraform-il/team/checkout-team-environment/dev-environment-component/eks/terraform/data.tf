@@ -0,0 +1,10 @@
+
+data "terraform_remote_state" "networking" {
+	backend = "s3"
+	config = {
+		region  = "us-east-1"
+		profile = "compuzest-shared"
+		bucket  = "zlifecycle--tfstate-zmart"
+		key     = "checkout/dev/networking/terraform.tfstate"
+	}
+}
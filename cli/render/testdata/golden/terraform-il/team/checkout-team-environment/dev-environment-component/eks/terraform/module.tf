@@ -0,0 +1,5 @@
+module "eks" {
+	source  = "git@github.com:terraform-aws-modules/terraform-aws-eks.git"
+	version = "18.26.0"
+	vpc_id = data.terraform_remote_state.networking.outputs.vpc_id
+}
@@ -0,0 +1,5 @@
+provider "aws" {
+	region  = "us-east-1"
+	profile = "compuzest-shared"
+	alias   = "shared"
+}
@@ -0,0 +1,10 @@
+terraform {
+    backend "s3" {
+        region         = "us-east-1"
+        bucket         = "zlifecycle--tfstate-zmart"
+        encrypt        = "true"
+        key            = "checkout/dev/eks/terraform.tfstate"
+        profile        = "compuzest-shared"
+        dynamodb_table = "zlifecycle--tflock-zmart"
+    }
+}
@@ -0,0 +1,9 @@
+terraform {
+	required_version = ">= 1.6.2"
+	required_providers {
+		aws = {
+			source  = "hashicorp/aws"
+			version = "~> 4.0"
+		}
+	}
+}
@@ -0,0 +1,5 @@
+module "networking" {
+	source  = "git@github.com:terraform-aws-modules/terraform-aws-vpc.git"
+	version = "3.14.0"
+	name = "checkout-dev"
+}
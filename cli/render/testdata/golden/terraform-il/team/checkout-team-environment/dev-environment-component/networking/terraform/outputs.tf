@@ -0,0 +1,3 @@
+output "vpc_id" {
+  	value = module.networking.vpc_id
+}
@@ -10,9 +10,10 @@ type Tags struct {
 }
 
 type Module struct {
-	Source string `json:"source" yaml:"source"`
-	Path   string `json:"path,omitempty" yaml:"path,omitempty"`
-	Name   string `json:"name,omitempty" yaml:"name,omitempty"`
+	Source  string `json:"source" yaml:"source"`
+	Path    string `json:"path,omitempty" yaml:"path,omitempty"`
+	Name    string `json:"name,omitempty" yaml:"name,omitempty"`
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
 }
 
 type VariablesFile struct {
@@ -27,6 +28,12 @@ type Variable struct {
 	ValueFrom string `json:"valueFrom,omitempty" yaml:"valueFrom,omitempty"`
 }
 
+type Secret struct {
+	Name  string `json:"name" yaml:"name"`
+	Scope string `json:"scope,omitempty" yaml:"scope,omitempty"`
+	Key   string `json:"key" yaml:"key"`
+}
+
 type Output struct {
 	Name string `json:"name" yaml:"name"`
 }
@@ -43,6 +50,7 @@ type EnvironmentComponent struct {
 
 	VariablesFile *VariablesFile `json:"variablesFile,omitempty" yaml:"variablesFile,omitempty"`
 	Variables     []*Variable    `json:"variables,omitempty" yaml:"variables,omitempty"`
+	Secrets       []*Secret      `json:"secrets,omitempty" yaml:"secrets,omitempty"`
 	Outputs       []*Output      `json:"outputs,omitempty" yaml:"outputs,omitempty"`
 }
 
@@ -0,0 +1,100 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/compuzest/zlifecycle-cli/common"
+	v1alpha1 "github.com/compuzest/zlifecycle-cli/types"
+	"github.com/compuzest/zlifecycle-il-operator/controller/codegen/il"
+	"github.com/compuzest/zlifecycle-il-operator/controller/codegen/terraform/tfinput"
+)
+
+// ValidateModuleInputs checks the variables, secrets and variables file of every terraform component
+// against the variables declared by its module. Only git sourced modules are checked.
+func ValidateModuleInputs(e v1alpha1.Environment, repos *common.RepoResolver) error {
+	cache := tfinput.NewModuleCache(0)
+	for _, ec := range e.Spec.EnvironmentComponent {
+		if err := checkModuleInputs(ec, repos, cache); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkModuleInputs(ec *v1alpha1.EnvironmentComponent, repos *common.RepoResolver, cache *tfinput.ModuleCache) error {
+	if ec.Type != "terraform" || ec.Module == nil {
+		return nil
+	}
+	source := il.EnvironmentComponentModuleSource(ec.Module.Source, ec.Module.Name)
+	if !il.IsGitModuleSource(source) {
+		return nil
+	}
+
+	module, err := cache.GetOrLoad(source, ec.Module.Version, ec.Module.Path, func() (*tfinput.Module, error) {
+		dir, err := repos.DirAt(source, ec.Module.Version)
+		if err != nil {
+			return nil, err
+		}
+		return tfinput.ParseModule(filepath.Join(dir, ec.Module.Path))
+	})
+	if err != nil {
+		return InvalidEnvironmentComponent{Component: ec.Name, Err: err}
+	}
+
+	var inputs []*tfinput.Input
+	for _, variable := range ec.Variables {
+		if variable.ValueFrom != "" {
+			inputs = append(inputs, tfinput.NewUnknownInput(variable.Name, tfinput.SourceVariables))
+		} else {
+			inputs = append(inputs, tfinput.NewInlineInput(variable.Name, variable.Value))
+		}
+	}
+	for _, secret := range ec.Secrets {
+		inputs = append(inputs, tfinput.NewUnknownInput(secret.Name, tfinput.SourceSecrets))
+	}
+	if ec.VariablesFile != nil {
+		fileInputs, err := readVariablesFileInputs(ec.VariablesFile, repos)
+		if err != nil {
+			return InvalidEnvironmentComponent{Component: ec.Name, Err: err}
+		}
+		inputs = append(inputs, fileInputs...)
+	}
+
+	inputErrs := tfinput.ValidateInputs(module.Variables, inputs)
+	if len(inputErrs) == 0 {
+		return nil
+	}
+	msgs := make([]string, 0, len(inputErrs))
+	for _, inputErr := range inputErrs {
+		if inputErr.Source != "" {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", inputErr.Source, inputErr.Detail))
+		} else {
+			msgs = append(msgs, inputErr.Detail)
+		}
+	}
+
+	return InvalidEnvironmentComponent{
+		Component: ec.Name,
+		Err:       errors.New(fmt.Sprintf("Component '%s' has invalid module inputs:\n  %s", ec.Name, strings.Join(msgs, "\n  "))),
+	}
+}
+
+func readVariablesFileInputs(vf *v1alpha1.VariablesFile, repos *common.RepoResolver) ([]*tfinput.Input, error) {
+	dir, err := repos.Dir(vf.Source)
+	if err != nil {
+		return nil, err
+	}
+	content, err := ioutil.ReadFile(filepath.Join(dir, vf.Path))
+	if err != nil {
+		return nil, err
+	}
+	hcl, err := tfinput.ParseVariablesFile(vf.Path, string(content))
+	if err != nil {
+		return nil, err
+	}
+	return tfinput.ParseVariablesFileInputs(vf.Path, hcl)
+}
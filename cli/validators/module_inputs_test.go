@@ -0,0 +1,176 @@
+package validators_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/compuzest/zlifecycle-cli/common"
+	v1alpha1 "github.com/compuzest/zlifecycle-cli/types"
+	"github.com/compuzest/zlifecycle-cli/validators"
+)
+
+const modulesRepo = "git@github.com:org/modules.git"
+
+const vpcVariables = `variable "name" {
+  type = string
+}
+
+variable "cidr" {
+  type    = string
+  default = "10.0.0.0/16"
+}
+`
+
+func newRepoResolver(t *testing.T) *common.RepoResolver {
+	t.Helper()
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "vpc"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "vpc", "variables.tf"), []byte(vpcVariables), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "dev.tfvars"), []byte("cidr = \"10.1.0.0/16\"\nsize = 3\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return common.NewRepoResolver(map[string]string{
+		modulesRepo: dir,
+		"git@github.com:terraform-aws-modules/terraform-aws-vpc.git": filepath.Join(dir, "vpc"),
+	})
+}
+
+func newEnvironment(ecs ...*v1alpha1.EnvironmentComponent) v1alpha1.Environment {
+	e := v1alpha1.Environment{}
+	e.Spec.EnvironmentComponent = ecs
+	return e
+}
+
+func TestValidateModuleInputs(t *testing.T) {
+	t.Parallel()
+
+	repos := newRepoResolver(t)
+
+	tests := []struct {
+		name string
+		ec   *v1alpha1.EnvironmentComponent
+		err  string
+	}{
+		{
+			name: "valid",
+			ec: &v1alpha1.EnvironmentComponent{
+				Name:      "networking",
+				Type:      "terraform",
+				Module:    &v1alpha1.Module{Source: modulesRepo, Path: "vpc"},
+				Variables: []*v1alpha1.Variable{{Name: "name", Value: `"checkout"`}},
+			},
+		},
+		{
+			name: "aws module",
+			ec: &v1alpha1.EnvironmentComponent{
+				Name:      "networking",
+				Type:      "terraform",
+				Module:    &v1alpha1.Module{Source: "aws", Name: "vpc"},
+				Variables: []*v1alpha1.Variable{{Name: "name", ValueFrom: "platform.name"}},
+			},
+		},
+		{
+			name: "missing variable",
+			ec: &v1alpha1.EnvironmentComponent{
+				Name:   "networking",
+				Type:   "terraform",
+				Module: &v1alpha1.Module{Source: modulesRepo, Path: "vpc"},
+			},
+			err: "name",
+		},
+		{
+			name: "undeclared secret",
+			ec: &v1alpha1.EnvironmentComponent{
+				Name:      "networking",
+				Type:      "terraform",
+				Module:    &v1alpha1.Module{Source: modulesRepo, Path: "vpc"},
+				Variables: []*v1alpha1.Variable{{Name: "name", Value: `"checkout"`}},
+				Secrets:   []*v1alpha1.Secret{{Name: "password", Key: "db_password"}},
+			},
+			err: "password",
+		},
+		{
+			name: "undeclared variables file variable",
+			ec: &v1alpha1.EnvironmentComponent{
+				Name:          "networking",
+				Type:          "terraform",
+				Module:        &v1alpha1.Module{Source: modulesRepo, Path: "vpc"},
+				Variables:     []*v1alpha1.Variable{{Name: "name", Value: `"checkout"`}},
+				VariablesFile: &v1alpha1.VariablesFile{Source: modulesRepo, Path: "dev.tfvars"},
+			},
+			err: "size",
+		},
+		{
+			name: "registry module is skipped",
+			ec: &v1alpha1.EnvironmentComponent{
+				Name:   "networking",
+				Type:   "terraform",
+				Module: &v1alpha1.Module{Source: "hashicorp/consul/aws"},
+			},
+		},
+		{
+			name: "argocd component is skipped",
+			ec: &v1alpha1.EnvironmentComponent{
+				Name:   "platform",
+				Type:   "argocd",
+				Module: &v1alpha1.Module{Source: modulesRepo, Path: "charts"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		err := validators.ValidateModuleInputs(newEnvironment(tt.ec), repos)
+		if tt.err == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", tt.name, err)
+			}
+			continue
+		}
+		var invalid validators.InvalidEnvironmentComponent
+		if !errors.As(err, &invalid) {
+			t.Errorf("%s: expected InvalidEnvironmentComponent error, got %v", tt.name, err)
+			continue
+		}
+		if invalid.Component != tt.ec.Name || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("%s: expected error about %s of component %s, got %v", tt.name, tt.err, tt.ec.Name, err)
+		}
+	}
+}
+
+func TestValidateEnvironment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		ecs   []*v1alpha1.EnvironmentComponent
+		valid bool
+	}{
+		{
+			name:  "valid dependencies",
+			ecs:   []*v1alpha1.EnvironmentComponent{{Name: "networking"}, {Name: "database", DependsOn: []string{"networking"}}},
+			valid: true,
+		},
+		{
+			name: "depends on itself",
+			ecs:  []*v1alpha1.EnvironmentComponent{{Name: "networking", DependsOn: []string{"networking"}}},
+		},
+		{
+			name: "depends on missing component",
+			ecs:  []*v1alpha1.EnvironmentComponent{{Name: "database", DependsOn: []string{"networking"}}},
+		},
+	}
+	for _, tt := range tests {
+		err := validators.ValidateEnvironment(newEnvironment(tt.ecs...))
+		if tt.valid != (err == nil) {
+			t.Errorf("%s: unexpected result: %v", tt.name, err)
+		}
+	}
+}